@@ -1,7 +1,11 @@
 // Package info provides the OSI presentation layer.
 package info
 
-import "errors"
+import (
+	"errors"
+	"math"
+	"time"
+)
 
 // CommonAddr is a station address. Zero is not used.
 // The width is controlled by Params.CommonAddrSize.
@@ -127,9 +131,172 @@ func (p StepPos) Split() (value int, transient bool, q QualDesc) {
 // See companion standard 101, subclause 7.2.6.6.
 type Normal int16
 
+// NewNormal returns the normalized value closest to v.
+// Values out of range (-1, 1 − 2⁻¹⁵) are clamped.
+func NewNormal(v float64) Normal {
+	const max = 1 - 1.0/32768
+	switch {
+	case v > max:
+		v = max
+	case v < -1:
+		v = -1
+	}
+	return Normal(math.Round(v * 32768))
+}
+
 // Float64 returns the actual value in the range of (-1, 1 − 2⁻¹⁵)
 func (n Normal) Float64() float64 { return float64(n) / 32768 }
 
+// Scaled is a 16-bit scaled value including quality descriptor.
+// See companion standard 101, subclause 7.2.6.7.
+type Scaled uint32
+
+// NewScaled returns a new scaled value.
+func NewScaled(value int16, q QualDesc) Scaled {
+	return Scaled(uint16(value)) | Scaled(q)<<16
+}
+
+// Split returns the value and the quality descriptor flags separated.
+func (s Scaled) Split() (value int16, q QualDesc) {
+	value = int16(uint16(s))
+	q = QualDesc(s >> 16)
+	return
+}
+
+// ShortFloat is an IEEE 754 short floating-point value including
+// quality descriptor.
+// See companion standard 101, subclause 7.2.6.8.
+type ShortFloat uint64
+
+// NewShortFloat returns a new short floating-point value.
+func NewShortFloat(value float32, q QualDesc) ShortFloat {
+	return ShortFloat(math.Float32bits(value)) | ShortFloat(q)<<32
+}
+
+// Split returns the value and the quality descriptor flags separated.
+func (f ShortFloat) Split() (value float32, q QualDesc) {
+	value = math.Float32frombits(uint32(f))
+	q = QualDesc(f >> 32)
+	return
+}
+
+// BinaryCounterReading is an integrated totals value.
+// See companion standard 101, subclause 7.2.6.9.
+type BinaryCounterReading struct {
+	Value int32
+	flags uint8
+}
+
+// NewBCR returns a new binary counter reading.
+// The function panics when seq exceeds range (0, 31).
+func NewBCR(value int32, seq uint, carry, adjusted, invalid bool) BinaryCounterReading {
+	if seq > 31 {
+		panic("sequence number out of range")
+	}
+	f := uint8(seq)
+	if carry {
+		f |= 1 << 5
+	}
+	if adjusted {
+		f |= 1 << 6
+	}
+	if invalid {
+		f |= 1 << 7
+	}
+	return BinaryCounterReading{Value: value, flags: f}
+}
+
+// SeqNum returns the sequence number in range (0, 31).
+func (r BinaryCounterReading) SeqNum() uint { return uint(r.flags & 0x1f) }
+
+// Carry flags a counter overflow during accumulation.
+func (r BinaryCounterReading) Carry() bool { return r.flags&(1<<5) != 0 }
+
+// Adjusted flags that the counter was adjusted by the system.
+func (r BinaryCounterReading) Adjusted() bool { return r.flags&(1<<6) != 0 }
+
+// Invalid flags that the value was incorrectly acquired.
+func (r BinaryCounterReading) Invalid() bool { return r.flags&(1<<7) != 0 }
+
+// QualDescP are the quality descriptor flags of protection equipment
+// events: EI, BL, SB, NT and IV.
+// See companion standard 101, subclause 7.2.6.4.
+type QualDescP uint
+
+const (
+	_ QualDescP = 1 << iota
+	_
+	_
+
+	// EI flags that the elapsed time was incorrectly acquired.
+	EI
+
+	// BL flags that the value is blocked for transmission; the
+	// value remains in the state that was acquired before it was blocked.
+	BL
+
+	// SB flags that the value was provided by the input of an
+	// operator (dispatcher) instead of an automatic source.
+	SB
+
+	// NT flags that the most recent update was unsuccessful.
+	NT
+
+	// IV flags that the value was incorrectly acquired.
+	IV
+
+	// OKP means no flags, no problems.
+	OKP = 0
+)
+
+// ProtectionEvent is a single event of protection equipment.
+// See companion standard 101, subclause 7.2.6.10.
+type ProtectionEvent uint
+
+// Split returns the state and the quality descriptor flags separated.
+func (e ProtectionEvent) Split() (DoublePoint, QualDescP) {
+	return DoublePoint(e & 3), QualDescP(e & 0xf8)
+}
+
+// ProtectionStart are the start events of protection equipment: general
+// start GeneralStart, start of operation in phase L1, L2 or L3, start of
+// operation for earth current StartEarthCurrent, and start of operation
+// in the reverse direction StartReverseDirection.
+// See companion standard 101, subclause 7.2.6.11.
+type ProtectionStart uint
+
+const (
+	GeneralStart ProtectionStart = 1 << iota
+	StartL1
+	StartL2
+	StartL3
+	StartEarthCurrent
+	StartReverseDirection
+)
+
+// Split returns the start flags and the quality descriptor flags separated.
+func (s ProtectionStart) Split() (ProtectionStart, QualDescP) {
+	return s & 0x3f, QualDescP(s >> 8)
+}
+
+// ProtectionOutput are the output circuit information flags of
+// protection equipment: general command GeneralCommand plus the
+// command to phase L1, L2 or L3.
+// See companion standard 101, subclause 7.2.6.12.
+type ProtectionOutput uint
+
+const (
+	GeneralCommand ProtectionOutput = 1 << iota
+	CommandL1
+	CommandL2
+	CommandL3
+)
+
+// Split returns the output flags and the quality descriptor flags separated.
+func (o ProtectionOutput) Split() (ProtectionOutput, QualDescP) {
+	return o & 0xf, QualDescP(o >> 8)
+}
+
 // SingleCmd is a singe command.
 // See companion standard 101, subclause 7.2.6.15.
 type SingleCmd struct{ Cmd }
@@ -255,4 +422,175 @@ func (c SetpointCmd) Qual() uint { return uint(c & 127) }
 
 // Exec returns whether the command executes (or selects).
 // See section 5, subclause 6.8.
-func (c SetpointCmd) Exec() bool { return c&128 == 0 }
\ No newline at end of file
+func (c SetpointCmd) Exec() bool { return c&128 == 0 }
+
+// SetpointNormalCmd is a set-point command with a normalized value.
+// See companion standard 101, subclause 7.2.6.39.
+type SetpointNormalCmd struct {
+	value Normal
+	SetpointCmd
+}
+
+// NewSetpointNormalCmd returns a new set-point command.
+// The function panics when the qualifier exceeds range (0, 127).
+func NewSetpointNormalCmd(v Normal, qual uint, exec bool) SetpointNormalCmd {
+	return SetpointNormalCmd{v, NewSetpointCmd(qual, exec)}
+}
+
+// Value returns the command's set-point.
+func (c SetpointNormalCmd) Value() Normal { return c.value }
+
+// SetpointScaledCmd is a set-point command with a scaled value.
+// See companion standard 101, subclause 7.2.6.39.
+type SetpointScaledCmd struct {
+	value Scaled
+	SetpointCmd
+}
+
+// NewSetpointScaledCmd returns a new set-point command.
+// The function panics when the qualifier exceeds range (0, 127).
+func NewSetpointScaledCmd(v Scaled, qual uint, exec bool) SetpointScaledCmd {
+	return SetpointScaledCmd{v, NewSetpointCmd(qual, exec)}
+}
+
+// Value returns the command's set-point.
+func (c SetpointScaledCmd) Value() Scaled { return c.value }
+
+// SetpointFloatCmd is a set-point command with a short floating-point value.
+// See companion standard 101, subclause 7.2.6.39.
+type SetpointFloatCmd struct {
+	value ShortFloat
+	SetpointCmd
+}
+
+// NewSetpointFloatCmd returns a new set-point command.
+// The function panics when the qualifier exceeds range (0, 127).
+func NewSetpointFloatCmd(v ShortFloat, qual uint, exec bool) SetpointFloatCmd {
+	return SetpointFloatCmd{v, NewSetpointCmd(qual, exec)}
+}
+
+// Value returns the command's set-point.
+func (c SetpointFloatCmd) Value() ShortFloat { return c.value }
+
+// BitstringCmd is a bitstring of 32 bits command.
+// See companion standard 101, subclause 7.2.6.40.
+type BitstringCmd uint32
+
+// NewBitstringCmd returns a new bitstring command.
+func NewBitstringCmd(bits uint32) BitstringCmd { return BitstringCmd(bits) }
+
+// Bits returns the command's bitstring.
+func (c BitstringCmd) Bits() uint32 { return uint32(c) }
+
+// CP16Time2a is a 16-bit binary time, the millisecond count within a
+// minute, in range (0, 59999).
+// See companion standard 101, subclause 7.2.6.18.
+type CP16Time2a uint
+
+// NewCP16Time2a returns a new 16-bit binary time.
+// The function panics when ms exceeds range (0, 59999).
+func NewCP16Time2a(ms uint) CP16Time2a {
+	if ms > 59999 {
+		panic("millisecond count out of range")
+	}
+	return CP16Time2a(ms)
+}
+
+// Split returns the millisecond count within the minute.
+func (t CP16Time2a) Split() (ms uint) { return uint(t) }
+
+// CP24Time2a is a 24-bit binary time: the millisecond count within a
+// minute plus the minute itself, with an IV "invalid" flag in bit 7 of
+// the minute octet.
+// See companion standard 101, subclause 7.2.6.19.
+type CP24Time2a uint
+
+// NewCP24Time2a returns a new 24-bit binary time.
+// The function panics when ms or minute are out of range.
+func NewCP24Time2a(ms, minute uint, invalid bool) CP24Time2a {
+	if ms > 59999 {
+		panic("millisecond count out of range")
+	}
+	if minute > 59 {
+		panic("minute out of range")
+	}
+	t := CP24Time2a(ms) | CP24Time2a(minute)<<16
+	if invalid {
+		t |= 1 << 23
+	}
+	return t
+}
+
+// Split returns the millisecond count within the minute, the minute
+// and the IV "invalid" flag separated.
+func (t CP24Time2a) Split() (ms, minute uint, invalid bool) {
+	ms = uint(t & 0xffff)
+	minute = uint(t>>16) & 0x3f
+	invalid = t&(1<<23) != 0
+	return
+}
+
+// CP56Time2a is a 56-bit binary time: millisecond, minute, hour, day of
+// month, day of week, month and year, packed octet by octet as defined
+// by the standard, including the IV "invalid" and SU "summer time" flags.
+// See companion standard 101, subclause 7.2.6.20.
+type CP56Time2a uint64
+
+// NewCP56Time2a returns a new 56-bit binary time for t.
+func NewCP56Time2a(t time.Time, invalid, summerTime bool) CP56Time2a {
+	ms := uint(t.Second())*1000 + uint(t.Nanosecond())/1e6
+	v := CP56Time2a(ms)
+	v |= CP56Time2a(t.Minute()) << 16
+	if invalid {
+		v |= 1 << 23
+	}
+	v |= CP56Time2a(t.Hour()) << 24
+	if summerTime {
+		v |= 1 << 31
+	}
+	v |= CP56Time2a(t.Day()) << 32
+	dow := t.Weekday()
+	if dow == time.Sunday {
+		dow = 7
+	}
+	v |= CP56Time2a(dow) << 37
+	v |= CP56Time2a(t.Month()) << 40
+	v |= CP56Time2a(t.Year()%100) << 48
+	return v
+}
+
+// Split returns the binary time's fields separated: the millisecond
+// count within the minute, the minute, the IV "invalid" flag, the hour,
+// the SU "summer time" flag, the day of month, the day of week (1
+// Monday ‥ 7 Sunday), the month and the two-digit year.
+func (t CP56Time2a) Split() (ms, minute uint, invalid bool, hour uint, summerTime bool, day, dayOfWeek, month, year uint) {
+	ms = uint(t & 0xffff)
+	minute = uint(t>>16) & 0x3f
+	invalid = t&(1<<23) != 0
+	hour = uint(t>>24) & 0x1f
+	summerTime = t&(1<<31) != 0
+	day = uint(t>>32) & 0x1f
+	dayOfWeek = uint(t>>37) & 0x7
+	month = uint(t>>40) & 0xf
+	year = uint(t>>48) & 0x7f
+	return
+}
+
+// Time reconstructs the calendar time in loc plus the IV "invalid" flag.
+// The two-digit year is resolved into the 100-year window centered on
+// the current year (at most 50 years back or ahead of time.Now), so that
+// timestamps near the present round-trip.
+func (t CP56Time2a) Time(loc *time.Location) (time.Time, bool) {
+	ms, minute, invalid, hour, _, day, _, month, year := t.Split()
+	now := time.Now()
+	full := now.Year()/100*100 + int(year)
+	switch {
+	case full-now.Year() > 50:
+		full -= 100
+	case now.Year()-full > 50:
+		full += 100
+	}
+	sec := ms / 1000
+	nsec := (ms % 1000) * 1e6
+	return time.Date(full, time.Month(month), int(day), int(hour), int(minute), int(sec), int(nsec), loc), invalid
+}